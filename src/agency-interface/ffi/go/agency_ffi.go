@@ -5,21 +5,12 @@ package agency
 // #include "../agency_ffi.h"
 import "C"
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"unsafe"
 )
 
-// AgencyError represents an error from the agency FFI interface.
-type AgencyError struct {
-	Message string
-}
-
-// Error implements the error interface for AgencyError.
-func (e AgencyError) Error() string {
-	return e.Message
-}
-
 // Agency represents a federal agency.
 type Agency struct {
 	Acronym     string   `json:"acronym"`
@@ -30,14 +21,23 @@ type Agency struct {
 	SubAgencies []Agency `json:"sub_agencies,omitempty"`
 }
 
-// GetContext returns the context information for an agency.
-func GetContext(agency string) (map[string]interface{}, error) {
+// GetContextCtx returns the context information for an agency, honoring
+// ctx's deadline and cancellation. A cancelled or expired ctx aborts the
+// underlying prover/context lookup rather than leaking it to completion.
+func GetContextCtx(ctx context.Context, agency string) (map[string]interface{}, error) {
 	cAgency := C.CString(agency)
 	defer C.free(unsafe.Pointer(cAgency))
 
-	contextPtr := C.agency_get_context(cAgency)
+	handle := C.agency_get_context_start(cAgency)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return nil, err
+	}
+
+	contextPtr := C.agency_op_result_str(handle)
 	if contextPtr == nil {
-		return nil, AgencyError{"Failed to get context for agency"}
+		return nil, lastOpError(handle)
 	}
 	defer C.agency_free_context(contextPtr)
 
@@ -51,53 +51,85 @@ func GetContext(agency string) (map[string]interface{}, error) {
 	return context, nil
 }
 
-// GetIssueFinder returns the issue finder data for an agency.
-func GetIssueFinder(agency string) (string, error) {
+// GetIssueFinderCtx returns the issue finder data for an agency, honoring
+// ctx's deadline and cancellation.
+func GetIssueFinderCtx(ctx context.Context, agency string) (string, error) {
 	cAgency := C.CString(agency)
 	defer C.free(unsafe.Pointer(cAgency))
 
-	finderPtr := C.agency_get_issue_finder(cAgency)
+	handle := C.agency_get_issue_finder_start(cAgency)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return "", err
+	}
+
+	finderPtr := C.agency_op_result_str(handle)
 	if finderPtr == nil {
-		return "", AgencyError{"Failed to get issue finder for agency"}
+		return "", lastOpError(handle)
 	}
 	defer C.agency_free_context(finderPtr)
 
 	return C.GoString(finderPtr), nil
 }
 
-// GetResearchConnector returns the research connector data for an agency.
-func GetResearchConnector(agency string) (string, error) {
+// GetResearchConnectorCtx returns the research connector data for an
+// agency, honoring ctx's deadline and cancellation.
+func GetResearchConnectorCtx(ctx context.Context, agency string) (string, error) {
 	cAgency := C.CString(agency)
 	defer C.free(unsafe.Pointer(cAgency))
 
-	connectorPtr := C.agency_get_research_connector(cAgency)
+	handle := C.agency_get_research_connector_start(cAgency)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return "", err
+	}
+
+	connectorPtr := C.agency_op_result_str(handle)
 	if connectorPtr == nil {
-		return "", AgencyError{"Failed to get research connector for agency"}
+		return "", lastOpError(handle)
 	}
 	defer C.agency_free_context(connectorPtr)
 
 	return C.GoString(connectorPtr), nil
 }
 
-// GetAsciiArt returns the ASCII art for an agency.
-func GetAsciiArt(agency string) (string, error) {
+// GetAsciiArtCtx returns the ASCII art for an agency, honoring ctx's
+// deadline and cancellation.
+func GetAsciiArtCtx(ctx context.Context, agency string) (string, error) {
 	cAgency := C.CString(agency)
 	defer C.free(unsafe.Pointer(cAgency))
 
-	artPtr := C.agency_get_ascii_art(cAgency)
+	handle := C.agency_get_ascii_art_start(cAgency)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return "", err
+	}
+
+	artPtr := C.agency_op_result_str(handle)
 	if artPtr == nil {
-		return "", AgencyError{"Failed to get ASCII art for agency"}
+		return "", lastOpError(handle)
 	}
 	defer C.agency_free_context(artPtr)
 
 	return C.GoString(artPtr), nil
 }
 
-// GetAllAgencies returns a list of all available agencies.
-func GetAllAgencies() ([]string, error) {
-	agenciesPtr := C.agency_get_all_agencies()
+// GetAllAgenciesCtx returns a list of all available agencies, honoring
+// ctx's deadline and cancellation.
+func GetAllAgenciesCtx(ctx context.Context) ([]string, error) {
+	handle := C.agency_get_all_agencies_start()
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return nil, err
+	}
+
+	agenciesPtr := C.agency_op_result_str(handle)
 	if agenciesPtr == nil {
-		return nil, AgencyError{"Failed to get agencies"}
+		return nil, lastOpError(handle)
 	}
 	defer C.agency_free_context(agenciesPtr)
 
@@ -111,11 +143,19 @@ func GetAllAgencies() ([]string, error) {
 	return agencies, nil
 }
 
-// GetAgenciesByTier returns a list of agencies in a specific tier.
-func GetAgenciesByTier(tier int) ([]string, error) {
-	agenciesPtr := C.agency_get_agencies_by_tier(C.int(tier))
+// GetAgenciesByTierCtx returns a list of agencies in a specific tier,
+// honoring ctx's deadline and cancellation.
+func GetAgenciesByTierCtx(ctx context.Context, tier int) ([]string, error) {
+	handle := C.agency_get_agencies_by_tier_start(C.int(tier))
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return nil, err
+	}
+
+	agenciesPtr := C.agency_op_result_str(handle)
 	if agenciesPtr == nil {
-		return nil, AgencyError{"Failed to get agencies for tier"}
+		return nil, lastOpError(handle)
 	}
 	defer C.agency_free_context(agenciesPtr)
 
@@ -129,14 +169,22 @@ func GetAgenciesByTier(tier int) ([]string, error) {
 	return agencies, nil
 }
 
-// GetAgenciesByDomain returns a list of agencies for a specific domain.
-func GetAgenciesByDomain(domain string) ([]string, error) {
+// GetAgenciesByDomainCtx returns a list of agencies for a specific domain,
+// honoring ctx's deadline and cancellation.
+func GetAgenciesByDomainCtx(ctx context.Context, domain string) ([]string, error) {
 	cDomain := C.CString(domain)
 	defer C.free(unsafe.Pointer(cDomain))
 
-	agenciesPtr := C.agency_get_agencies_by_domain(cDomain)
+	handle := C.agency_get_agencies_by_domain_start(cDomain)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return nil, err
+	}
+
+	agenciesPtr := C.agency_op_result_str(handle)
 	if agenciesPtr == nil {
-		return nil, AgencyError{"Failed to get agencies for domain"}
+		return nil, lastOpError(handle)
 	}
 	defer C.agency_free_context(agenciesPtr)
 
@@ -150,8 +198,10 @@ func GetAgenciesByDomain(domain string) ([]string, error) {
 	return agencies, nil
 }
 
-// VerifyIssue verifies an issue using the agency theorem prover.
-func VerifyIssue(agency string, issue map[string]interface{}) (bool, error) {
+// VerifyIssueCtx verifies an issue using the agency theorem prover,
+// honoring ctx's deadline and cancellation. A cancelled or expired ctx
+// aborts the in-flight prover run instead of letting it run to completion.
+func VerifyIssueCtx(ctx context.Context, agency string, issue map[string]interface{}) (bool, error) {
 	cAgency := C.CString(agency)
 	defer C.free(unsafe.Pointer(cAgency))
 
@@ -163,25 +213,26 @@ func VerifyIssue(agency string, issue map[string]interface{}) (bool, error) {
 	cIssueJSON := C.CString(string(issueJSON))
 	defer C.free(unsafe.Pointer(cIssueJSON))
 
-	result := C.agency_verify_issue(cAgency, cIssueJSON)
-	switch result {
+	handle := C.agency_verify_issue_start(cAgency, cIssueJSON)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(ctx, handle); err != nil {
+		return false, err
+	}
+
+	switch C.agency_op_result_int(handle) {
 	case 1:
 		return true, nil
 	case 0:
 		return false, nil
 	default:
-		return false, AgencyError{"Error verifying issue"}
+		return false, lastOpError(handle)
 	}
 }
 
-// GetAgencyInfo returns agency information as a struct.
-func GetAgencyInfo(agency string) (*Agency, error) {
-	context, err := GetContext(agency)
-	if err != nil {
-		return nil, err
-	}
-
-	// Extract agency information
+// agencyInfoFromContext builds an Agency struct out of the raw context map
+// returned by GetContext/GetContextCtx.
+func agencyInfoFromContext(agency string, context map[string]interface{}) *Agency {
 	agencyInfo := &Agency{
 		Acronym:     agency,
 		Name:        "",
@@ -225,5 +276,5 @@ func GetAgencyInfo(agency string) (*Agency, error) {
 		}
 	}
 
-	return agencyInfo, nil
-}
\ No newline at end of file
+	return agencyInfo
+}
@@ -0,0 +1,173 @@
+package agency
+
+// #include <stdlib.h>
+// #include "../agency_ffi.h"
+import "C"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"unsafe"
+)
+
+// GetContextsBatch returns the context for every agency in agencies in a
+// single FFI round-trip, amortizing the cgo and JSON marshal cost that
+// looping GetContext per agency would pay.
+func GetContextsBatch(agencies []string) (map[string]map[string]interface{}, error) {
+	agenciesJSON, err := json.Marshal(agencies)
+	if err != nil {
+		return nil, errors.New("failed to serialize agencies: " + err.Error())
+	}
+
+	cAgenciesJSON := C.CString(string(agenciesJSON))
+	defer C.free(unsafe.Pointer(cAgenciesJSON))
+
+	handle := C.agency_get_contexts_batch_start(cAgenciesJSON)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(context.Background(), handle); err != nil {
+		return nil, err
+	}
+
+	resultPtr := C.agency_op_result_str(handle)
+	if resultPtr == nil {
+		return nil, lastOpError(handle)
+	}
+	defer C.agency_free_context(resultPtr)
+
+	var contexts map[string]map[string]interface{}
+	if err := json.Unmarshal([]byte(C.GoString(resultPtr)), &contexts); err != nil {
+		return nil, errors.New("failed to parse contexts JSON: " + err.Error())
+	}
+
+	return contexts, nil
+}
+
+// verifyIssueResult is the wire shape of a single entry in the JSON array
+// returned by agency_verify_issues_batch.
+type verifyIssueResult struct {
+	Verified bool   `json:"verified"`
+	Error    string `json:"error"`
+}
+
+// parseVerifyIssuesBatchResult decodes the JSON array returned by
+// agency_verify_issues_batch into parallel results/errs slices, one entry
+// per issue in the original request. It is factored out of
+// VerifyIssuesBatch as pure logic so it can be unit-tested without the
+// real cgo bindings. wantLen must equal the number of issues the batch
+// was requested for; a mismatch (truncated batch, partial failure,
+// version skew) is reported as an error rather than silently returning
+// misaligned slices.
+func parseVerifyIssuesBatchResult(data []byte, wantLen int) ([]bool, []error, error) {
+	var raw []verifyIssueResult
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, nil, errors.New("failed to parse verification results JSON: " + err.Error())
+	}
+
+	if len(raw) != wantLen {
+		return nil, nil, fmt.Errorf("verification batch returned %d results, want %d", len(raw), wantLen)
+	}
+
+	results := make([]bool, len(raw))
+	errs := make([]error, len(raw))
+	for i, r := range raw {
+		results[i] = r.Verified
+		if r.Error != "" {
+			errs[i] = errors.New(r.Error)
+		}
+	}
+
+	return results, errs, nil
+}
+
+// VerifyIssuesBatch verifies each issue in issues against agency's theorem
+// prover in a single FFI round-trip. The returned slices are parallel to
+// issues: results[i] holds the verification outcome for issues[i], and
+// errs[i] holds any per-issue error (e.g. a malformed issue) without
+// aborting verification of the remaining issues.
+func VerifyIssuesBatch(agency string, issues []map[string]interface{}) ([]bool, []error, error) {
+	cAgency := C.CString(agency)
+	defer C.free(unsafe.Pointer(cAgency))
+
+	issuesJSON, err := json.Marshal(issues)
+	if err != nil {
+		return nil, nil, errors.New("failed to serialize issues: " + err.Error())
+	}
+
+	cIssuesJSON := C.CString(string(issuesJSON))
+	defer C.free(unsafe.Pointer(cIssuesJSON))
+
+	handle := C.agency_verify_issues_batch_start(cAgency, cIssuesJSON)
+	defer C.agency_op_destroy(handle)
+
+	if err := waitOp(context.Background(), handle); err != nil {
+		return nil, nil, err
+	}
+
+	resultPtr := C.agency_op_result_str(handle)
+	if resultPtr == nil {
+		return nil, nil, lastOpError(handle)
+	}
+	defer C.agency_free_context(resultPtr)
+
+	return parseVerifyIssuesBatchResult([]byte(C.GoString(resultPtr)), len(issues))
+}
+
+// StreamAgencies walks the full agency tree lazily, invoking visit for
+// each agency as it is produced rather than materializing every Agency up
+// front. It stops and returns ctx.Err() if ctx is cancelled, or the first
+// error returned by visit.
+func StreamAgencies(ctx context.Context, visit func(Agency) error) error {
+	iter, err := openAgencyIter()
+	if err != nil {
+		return err
+	}
+	defer C.agency_iter_close(iter)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		entryPtr := C.agency_iter_next(iter)
+		if entryPtr == nil {
+			if err := lastIterError(iter); err.Code != ErrNone {
+				return err
+			}
+			return nil
+		}
+
+		entryStr := C.GoString(entryPtr)
+		C.agency_free_context(entryPtr)
+
+		var entry Agency
+		if err := json.Unmarshal([]byte(entryStr), &entry); err != nil {
+			return errors.New("failed to parse agency JSON: " + err.Error())
+		}
+
+		if err := visit(entry); err != nil {
+			return err
+		}
+	}
+}
+
+// openAgencyIter opens a new iterator, reporting the thread-local FFI
+// error on failure. agency_iter_open has no handle of its own to carry
+// error state, so (unlike every other failure path in this package) the
+// triggering call and the error read must happen on the same OS thread;
+// runtime.LockOSThread pins the calling goroutine for exactly that
+// window.
+func openAgencyIter() (C.agency_iter_t, error) {
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	iter := C.agency_iter_open()
+	if iter == nil {
+		return nil, lastError()
+	}
+	return iter, nil
+}
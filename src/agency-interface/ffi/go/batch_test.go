@@ -0,0 +1,106 @@
+package agency
+
+import "testing"
+
+func TestParseVerifyIssuesBatchResult(t *testing.T) {
+	data := []byte(`[{"verified":true,"error":""},{"verified":false,"error":"missing field 'amount'"}]`)
+
+	results, errs, err := parseVerifyIssuesBatchResult(data, 2)
+	if err != nil {
+		t.Fatalf("parseVerifyIssuesBatchResult returned error: %v", err)
+	}
+
+	wantResults := []bool{true, false}
+	for i, want := range wantResults {
+		if results[i] != want {
+			t.Errorf("results[%d] = %v, want %v", i, results[i], want)
+		}
+	}
+
+	if errs[0] != nil {
+		t.Errorf("errs[0] = %v, want nil", errs[0])
+	}
+	if errs[1] == nil || errs[1].Error() != "missing field 'amount'" {
+		t.Errorf("errs[1] = %v, want %q", errs[1], "missing field 'amount'")
+	}
+}
+
+func TestParseVerifyIssuesBatchResultLengthMismatch(t *testing.T) {
+	data := []byte(`[{"verified":true,"error":""}]`)
+
+	_, _, err := parseVerifyIssuesBatchResult(data, 2)
+	if err == nil {
+		t.Fatal("parseVerifyIssuesBatchResult returned nil error for a length mismatch, want an error")
+	}
+}
+
+func TestParseVerifyIssuesBatchResultInvalidJSON(t *testing.T) {
+	_, _, err := parseVerifyIssuesBatchResult([]byte("not json"), 1)
+	if err == nil {
+		t.Fatal("parseVerifyIssuesBatchResult returned nil error for invalid JSON, want an error")
+	}
+}
+
+// benchAgencies is a representative slice of acronyms used to size the
+// batch benchmarks against the full GetAllAgencies population.
+var benchAgencies = []string{"DOD", "DOJ", "DOE", "DOT", "HHS", "DHS", "EPA", "NASA"}
+
+// BenchmarkGetContextLoop measures the cost of fetching context for every
+// agency in benchAgencies via N separate FFI round-trips.
+func BenchmarkGetContextLoop(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		for _, a := range benchAgencies {
+			if _, err := GetContext(a); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkGetContextsBatch measures the cost of fetching context for the
+// same agencies via a single batched FFI round-trip.
+func BenchmarkGetContextsBatch(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		if _, err := GetContextsBatch(benchAgencies); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// benchIssue is a representative issue payload used to size the batch
+// verification benchmarks.
+var benchIssue = map[string]interface{}{"kind": "budget-overrun", "amount": 1000}
+
+// BenchmarkVerifyIssueLoop measures the cost of verifying N issues via N
+// separate FFI round-trips.
+func BenchmarkVerifyIssueLoop(b *testing.B) {
+	issues := make([]map[string]interface{}, 50)
+	for i := range issues {
+		issues[i] = benchIssue
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, issue := range issues {
+			if _, err := VerifyIssue("DOD", issue); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkVerifyIssuesBatch measures the cost of verifying the same
+// issues via a single batched FFI round-trip.
+func BenchmarkVerifyIssuesBatch(b *testing.B) {
+	issues := make([]map[string]interface{}, 50)
+	for i := range issues {
+		issues[i] = benchIssue
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := VerifyIssuesBatch("DOD", issues); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
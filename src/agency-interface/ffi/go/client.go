@@ -0,0 +1,125 @@
+package agency
+
+import "context"
+
+//go:generate mockgen -destination=mocks/mock_client.go -package=mocks github.com/HardisonCo/HMS-A2A/src/agency-interface/ffi/go Client
+
+// Client exposes every agency FFI operation behind an interface, so
+// callers can inject a fake or an alternative backend (e.g. HTTP or gRPC)
+// instead of linking the real libagency_ffi.a. NewFFIClient returns the
+// implementation backed by the real cgo bindings.
+type Client interface {
+	GetContext(agency string) (map[string]interface{}, error)
+	GetIssueFinder(agency string) (string, error)
+	GetResearchConnector(agency string) (string, error)
+	GetAsciiArt(agency string) (string, error)
+	GetAllAgencies() ([]string, error)
+	GetAgenciesByTier(tier int) ([]string, error)
+	GetAgenciesByDomain(domain string) ([]string, error)
+	VerifyIssue(agency string, issue map[string]interface{}) (bool, error)
+	GetAgencyInfo(agency string) (*Agency, error)
+}
+
+// ffiClient is the Client implementation backed by the real cgo bindings.
+type ffiClient struct{}
+
+// NewFFIClient returns a Client backed by the real libagency_ffi.a bindings.
+func NewFFIClient() Client {
+	return ffiClient{}
+}
+
+// ffiClient's methods call straight into the *Ctx cgo implementations
+// (not the package-level convenience functions below), since those
+// functions route through DefaultClient and would otherwise recurse back
+// into ffiClient.
+
+func (ffiClient) GetContext(agency string) (map[string]interface{}, error) {
+	return GetContextCtx(context.Background(), agency)
+}
+
+func (ffiClient) GetIssueFinder(agency string) (string, error) {
+	return GetIssueFinderCtx(context.Background(), agency)
+}
+
+func (ffiClient) GetResearchConnector(agency string) (string, error) {
+	return GetResearchConnectorCtx(context.Background(), agency)
+}
+
+func (ffiClient) GetAsciiArt(agency string) (string, error) {
+	return GetAsciiArtCtx(context.Background(), agency)
+}
+
+func (ffiClient) GetAllAgencies() ([]string, error) {
+	return GetAllAgenciesCtx(context.Background())
+}
+
+func (ffiClient) GetAgenciesByTier(tier int) ([]string, error) {
+	return GetAgenciesByTierCtx(context.Background(), tier)
+}
+
+func (ffiClient) GetAgenciesByDomain(domain string) ([]string, error) {
+	return GetAgenciesByDomainCtx(context.Background(), domain)
+}
+
+func (ffiClient) VerifyIssue(agency string, issue map[string]interface{}) (bool, error) {
+	return VerifyIssueCtx(context.Background(), agency, issue)
+}
+
+func (c ffiClient) GetAgencyInfo(agency string) (*Agency, error) {
+	ctx, err := c.GetContext(agency)
+	if err != nil {
+		return nil, err
+	}
+
+	return agencyInfoFromContext(agency, ctx), nil
+}
+
+// DefaultClient backs the package-level convenience functions below. Tests
+// and alternative backends can swap it out (e.g. for a MockClient or an
+// HTTP/gRPC-backed implementation) before those functions are called.
+var DefaultClient Client = NewFFIClient()
+
+// GetContext returns the context information for an agency.
+func GetContext(agency string) (map[string]interface{}, error) {
+	return DefaultClient.GetContext(agency)
+}
+
+// GetIssueFinder returns the issue finder data for an agency.
+func GetIssueFinder(agency string) (string, error) {
+	return DefaultClient.GetIssueFinder(agency)
+}
+
+// GetResearchConnector returns the research connector data for an agency.
+func GetResearchConnector(agency string) (string, error) {
+	return DefaultClient.GetResearchConnector(agency)
+}
+
+// GetAsciiArt returns the ASCII art for an agency.
+func GetAsciiArt(agency string) (string, error) {
+	return DefaultClient.GetAsciiArt(agency)
+}
+
+// GetAllAgencies returns a list of all available agencies.
+func GetAllAgencies() ([]string, error) {
+	return DefaultClient.GetAllAgencies()
+}
+
+// GetAgenciesByTier returns a list of agencies in a specific tier.
+func GetAgenciesByTier(tier int) ([]string, error) {
+	return DefaultClient.GetAgenciesByTier(tier)
+}
+
+// GetAgenciesByDomain returns a list of agencies for a specific domain.
+func GetAgenciesByDomain(domain string) ([]string, error) {
+	return DefaultClient.GetAgenciesByDomain(domain)
+}
+
+// VerifyIssue verifies an issue using the agency theorem prover.
+func VerifyIssue(agency string, issue map[string]interface{}) (bool, error) {
+	return DefaultClient.VerifyIssue(agency, issue)
+}
+
+// GetAgencyInfo returns agency information as a struct.
+func GetAgencyInfo(agency string) (*Agency, error) {
+	return DefaultClient.GetAgencyInfo(agency)
+}
@@ -0,0 +1,55 @@
+package agency_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	agency "github.com/HardisonCo/HMS-A2A/src/agency-interface/ffi/go"
+	"github.com/HardisonCo/HMS-A2A/src/agency-interface/ffi/go/mocks"
+)
+
+func TestDefaultClientRoutesPackageLevelCalls(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mock := mocks.NewMockClient(ctrl)
+	original := agency.DefaultClient
+	agency.DefaultClient = mock
+	defer func() { agency.DefaultClient = original }()
+
+	mock.EXPECT().GetContext("DOD").Return(map[string]interface{}{"name": "Department of Defense"}, nil)
+	mock.EXPECT().VerifyIssue("DOD", gomock.Any()).Return(true, nil)
+	mock.EXPECT().GetAllAgencies().Return([]string{"DOD", "EPA"}, nil)
+
+	ctx, err := agency.GetContext("DOD")
+	if err != nil {
+		t.Fatalf("GetContext returned error: %v", err)
+	}
+	if ctx["name"] != "Department of Defense" {
+		t.Errorf("GetContext = %v, want name=Department of Defense", ctx)
+	}
+
+	ok, err := agency.VerifyIssue("DOD", map[string]interface{}{"kind": "budget-overrun"})
+	if err != nil {
+		t.Fatalf("VerifyIssue returned error: %v", err)
+	}
+	if !ok {
+		t.Error("VerifyIssue = false, want true")
+	}
+
+	agencies, err := agency.GetAllAgencies()
+	if err != nil {
+		t.Fatalf("GetAllAgencies returned error: %v", err)
+	}
+	if len(agencies) != 2 {
+		t.Errorf("GetAllAgencies = %v, want 2 entries", agencies)
+	}
+}
+
+func TestMockClientSatisfiesClient(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var _ agency.Client = mocks.NewMockClient(ctrl)
+}
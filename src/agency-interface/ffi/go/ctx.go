@@ -0,0 +1,47 @@
+package agency
+
+// #include "../agency_ffi.h"
+import "C"
+import "context"
+
+// opPollIntervalMs bounds how long a single agency_op_wait call blocks before
+// waitOp's polling goroutine calls it again, mirroring the deadlineTimer
+// pattern used by netstack's gonet package: poll in short slices so the
+// polling goroutine notices a cancelled operation promptly without
+// busy-looping.
+const opPollIntervalMs = 100
+
+// waitOp blocks until handle completes or ctx is done, whichever comes
+// first. On cancellation or deadline it invokes agency_op_cancel so the C
+// side can abort the prover run and free any intermediate state; the
+// background operation's own goroutine still exits once agency_op_wait
+// returns rather than being forcibly killed.
+func waitOp(ctx context.Context, handle C.agency_op_handle_t) error {
+	return waitPoll(ctx,
+		func() bool { return C.agency_op_wait(handle, C.int(opPollIntervalMs)) == 1 },
+		func() { C.agency_op_cancel(handle) },
+	)
+}
+
+// waitPoll implements the poll-with-cancellation loop behind waitOp,
+// factored out so it can be exercised without a real cgo handle: pending
+// reports whether the operation is still in flight (called repeatedly,
+// roughly every opPollIntervalMs, until it returns false), and cancel
+// requests that an in-flight operation abort.
+func waitPoll(ctx context.Context, pending func() bool, cancel func()) error {
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for pending() {
+		}
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		cancel()
+		<-done
+		return ctx.Err()
+	}
+}
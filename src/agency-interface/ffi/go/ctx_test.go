@@ -0,0 +1,77 @@
+package agency
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWaitPollCompletes(t *testing.T) {
+	var calls int32
+	pending := func() bool {
+		return atomic.AddInt32(&calls, 1) < 3
+	}
+	cancelled := false
+	cancel := func() { cancelled = true }
+
+	if err := waitPoll(context.Background(), pending, cancel); err != nil {
+		t.Fatalf("waitPoll returned error: %v", err)
+	}
+	if cancelled {
+		t.Error("waitPoll called cancel for an operation that completed on its own")
+	}
+}
+
+func TestWaitPollCancellation(t *testing.T) {
+	unblock := make(chan struct{})
+	pending := func() bool {
+		select {
+		case <-unblock:
+			return false
+		default:
+			return true
+		}
+	}
+
+	cancelled := make(chan struct{})
+	cancel := func() {
+		close(cancelled)
+		close(unblock)
+	}
+
+	ctx, stop := context.WithCancel(context.Background())
+	stop()
+
+	err := waitPoll(ctx, pending, cancel)
+	if err != context.Canceled {
+		t.Fatalf("waitPoll returned %v, want context.Canceled", err)
+	}
+
+	select {
+	case <-cancelled:
+	default:
+		t.Error("waitPoll did not invoke cancel after ctx was cancelled")
+	}
+}
+
+func TestWaitPollDeadlineExceeded(t *testing.T) {
+	unblock := make(chan struct{})
+	pending := func() bool {
+		select {
+		case <-unblock:
+			return false
+		default:
+			return true
+		}
+	}
+	cancel := func() { close(unblock) }
+
+	ctx, stop := context.WithTimeout(context.Background(), time.Millisecond)
+	defer stop()
+
+	err := waitPoll(ctx, pending, cancel)
+	if err != context.DeadlineExceeded {
+		t.Fatalf("waitPoll returned %v, want context.DeadlineExceeded", err)
+	}
+}
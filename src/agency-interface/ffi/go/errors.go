@@ -0,0 +1,130 @@
+package agency
+
+// #include "../agency_ffi.h"
+import "C"
+import "fmt"
+
+// ErrorCode identifies a well-defined FFI failure mode, mirroring
+// agency_error_code_t on the C side.
+type ErrorCode int
+
+const (
+	// ErrNone indicates no error occurred.
+	ErrNone ErrorCode = iota
+	// ErrNoSuchAgency indicates the requested agency acronym is unknown.
+	ErrNoSuchAgency
+	// ErrInvalidIssue indicates the issue payload failed validation.
+	ErrInvalidIssue
+	// ErrProverInternal indicates the theorem prover failed internally.
+	ErrProverInternal
+	// ErrJSONParse indicates the FFI could not parse a JSON payload passed to
+	// it. Go-side failures to parse JSON returned by the FFI are reported as
+	// plain errors, not with this code.
+	ErrJSONParse
+	// ErrOOM indicates the FFI layer could not allocate memory.
+	ErrOOM
+)
+
+// String returns a short, stable name for the error code.
+func (c ErrorCode) String() string {
+	switch c {
+	case ErrNone:
+		return "none"
+	case ErrNoSuchAgency:
+		return "no_such_agency"
+	case ErrInvalidIssue:
+		return "invalid_issue"
+	case ErrProverInternal:
+		return "prover_internal"
+	case ErrJSONParse:
+		return "json_parse"
+	case ErrOOM:
+		return "oom"
+	default:
+		return "unknown"
+	}
+}
+
+// AgencyError represents a structured error from the agency FFI interface.
+// Code identifies the failure mode, Message is the primary human-readable
+// description, Minor gives secondary context, and Details carries any
+// free-form diagnostics (e.g. a prover stack trace).
+type AgencyError struct {
+	Code    ErrorCode
+	Message string
+	Minor   string
+	Details string
+}
+
+// Error implements the error interface for AgencyError.
+func (e AgencyError) Error() string {
+	if e.Minor == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s: %s", e.Message, e.Minor)
+}
+
+// Is allows errors.Is(err, ErrNoSuchAgency) and similar comparisons against
+// a bare ErrorCode.
+func (e AgencyError) Is(target error) bool {
+	code, ok := target.(ErrorCode)
+	return ok && e.Code == code
+}
+
+// Error implements the error interface so an ErrorCode can be used directly
+// as a sentinel with errors.Is.
+func (c ErrorCode) Error() string {
+	return c.String()
+}
+
+// lastError reads the thread-local error state populated by the most
+// recent synchronous FFI call (agency_get_context and friends,
+// agency_iter_open) and converts it into an AgencyError.
+//
+// Go's goroutine scheduler can migrate a goroutine to a different OS
+// thread at essentially any point, including between the triggering cgo
+// call and this read, which would silently read another thread's (or a
+// fresh thread's zero-valued) error state. Every call site MUST wrap the
+// triggering call and this read together in
+// runtime.LockOSThread()/defer runtime.UnlockOSThread() to prevent that.
+//
+// It must NOT be used after an asynchronous *_start operation: that work
+// runs on a separate background thread, so this goroutine's thread-local
+// state was never touched by it. Use lastOpError(handle) instead; for
+// agency_iter_next, use lastIterError(iter).
+func lastError() AgencyError {
+	return AgencyError{
+		Code:    ErrorCode(C.agency_last_error_code()),
+		Message: C.GoString(C.agency_last_error_message()),
+		Minor:   C.GoString(C.agency_last_error_minor()),
+		Details: C.GoString(C.agency_last_error_details()),
+	}
+}
+
+// lastOpError reads the error state captured for a completed asynchronous
+// operation and converts it into an AgencyError. Unlike lastError, this is
+// keyed on the operation's handle rather than the calling thread, so it
+// correctly reflects a failure that happened on the background thread
+// that ran the operation.
+func lastOpError(handle C.agency_op_handle_t) AgencyError {
+	return AgencyError{
+		Code:    ErrorCode(C.agency_op_last_error_code(handle)),
+		Message: C.GoString(C.agency_op_last_error_message(handle)),
+		Minor:   C.GoString(C.agency_op_last_error_minor(handle)),
+		Details: C.GoString(C.agency_op_last_error_details(handle)),
+	}
+}
+
+// lastIterError reads the error state captured for an iterator's most
+// recent agency_iter_next call and converts it into an AgencyError.
+// Unlike lastError, this is keyed on the iterator handle rather than the
+// calling thread, so it is immune to goroutine-to-OS-thread migration
+// between the agency_iter_next call and this read.
+func lastIterError(iter C.agency_iter_t) AgencyError {
+	return AgencyError{
+		Code:    ErrorCode(C.agency_iter_last_error_code(iter)),
+		Message: C.GoString(C.agency_iter_last_error_message(iter)),
+		Minor:   C.GoString(C.agency_iter_last_error_minor(iter)),
+		Details: C.GoString(C.agency_iter_last_error_details(iter)),
+	}
+}
@@ -0,0 +1,62 @@
+package agency
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestErrorCodeString(t *testing.T) {
+	cases := map[ErrorCode]string{
+		ErrNone:           "none",
+		ErrNoSuchAgency:   "no_such_agency",
+		ErrInvalidIssue:   "invalid_issue",
+		ErrProverInternal: "prover_internal",
+		ErrJSONParse:      "json_parse",
+		ErrOOM:            "oom",
+		ErrorCode(99):     "unknown",
+	}
+
+	for code, want := range cases {
+		if got := code.String(); got != want {
+			t.Errorf("ErrorCode(%d).String() = %q, want %q", code, got, want)
+		}
+	}
+}
+
+func TestAgencyErrorError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  AgencyError
+		want string
+	}{
+		{
+			name: "message only",
+			err:  AgencyError{Code: ErrNoSuchAgency, Message: "no such agency"},
+			want: "no such agency",
+		},
+		{
+			name: "message and minor",
+			err:  AgencyError{Code: ErrInvalidIssue, Message: "invalid issue", Minor: "missing field 'amount'"},
+			want: "invalid issue: missing field 'amount'",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.err.Error(); got != tc.want {
+				t.Errorf("Error() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAgencyErrorIs(t *testing.T) {
+	err := error(AgencyError{Code: ErrNoSuchAgency, Message: "no such agency"})
+
+	if !errors.Is(err, ErrNoSuchAgency) {
+		t.Error("errors.Is(err, ErrNoSuchAgency) = false, want true")
+	}
+	if errors.Is(err, ErrInvalidIssue) {
+		t.Error("errors.Is(err, ErrInvalidIssue) = true, want false")
+	}
+}
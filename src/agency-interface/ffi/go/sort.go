@@ -0,0 +1,207 @@
+package agency
+
+import "sort"
+
+// Comparator orders two agencies, returning a negative number if a should
+// sort before b, zero if they are equivalent, and a positive number if a
+// should sort after b.
+type Comparator func(a, b Agency) int
+
+// ByAcronym orders agencies lexicographically by their acronym.
+func ByAcronym(a, b Agency) int {
+	return compareStrings(a.Acronym, b.Acronym)
+}
+
+// ByName orders agencies lexicographically by their full name.
+func ByName(a, b Agency) int {
+	return compareStrings(a.Name, b.Name)
+}
+
+// ByTier orders agencies numerically by tier, lowest first.
+func ByTier(a, b Agency) int {
+	switch {
+	case a.Tier < b.Tier:
+		return -1
+	case a.Tier > b.Tier:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// ByDomainThenName orders agencies by domain, breaking ties by name.
+func ByDomainThenName(a, b Agency) int {
+	if d := compareStrings(a.Domain, b.Domain); d != 0 {
+		return d
+	}
+	return compareStrings(a.Name, b.Name)
+}
+
+// Reverse returns a Comparator that orders agencies in the opposite order
+// of cmp.
+func Reverse(cmp Comparator) Comparator {
+	return func(a, b Agency) int {
+		return -cmp(a, b)
+	}
+}
+
+func compareStrings(a, b string) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// SortAgencies sorts items in place according to cmp. The sort is not
+// guaranteed to be stable; use sort.SliceStable directly if that matters.
+func SortAgencies(items []Agency, cmp Comparator) {
+	sort.Slice(items, func(i, j int) bool {
+		return cmp(items[i], items[j]) < 0
+	})
+}
+
+// SortTree sorts root's SubAgencies according to cmp, recursively, at
+// every depth.
+func SortTree(root *Agency, cmp Comparator) {
+	if root == nil {
+		return
+	}
+
+	SortAgencies(root.SubAgencies, cmp)
+	for i := range root.SubAgencies {
+		SortTree(&root.SubAgencies[i], cmp)
+	}
+}
+
+// TraversalOrder selects how Walk visits an agency tree.
+type TraversalOrder int
+
+const (
+	// PreOrder visits a node before its children.
+	PreOrder TraversalOrder = iota
+	// PostOrder visits a node after its children.
+	PostOrder
+	// BreadthFirst visits nodes level by level, shallowest first.
+	BreadthFirst
+)
+
+// Walk traverses the tree rooted at root in the given order, calling
+// visit for each node. Traversal stops and returns the first error
+// returned by visit.
+func Walk(root *Agency, order TraversalOrder, visit func(*Agency) error) error {
+	if root == nil {
+		return nil
+	}
+
+	switch order {
+	case PostOrder:
+		return walkPostOrder(root, visit)
+	case BreadthFirst:
+		return walkBreadthFirst(root, visit)
+	default:
+		return walkPreOrder(root, visit)
+	}
+}
+
+func walkPreOrder(node *Agency, visit func(*Agency) error) error {
+	if err := visit(node); err != nil {
+		return err
+	}
+	for i := range node.SubAgencies {
+		if err := walkPreOrder(&node.SubAgencies[i], visit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func walkPostOrder(node *Agency, visit func(*Agency) error) error {
+	for i := range node.SubAgencies {
+		if err := walkPostOrder(&node.SubAgencies[i], visit); err != nil {
+			return err
+		}
+	}
+	return visit(node)
+}
+
+func walkBreadthFirst(root *Agency, visit func(*Agency) error) error {
+	queue := []*Agency{root}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+
+		if err := visit(node); err != nil {
+			return err
+		}
+
+		for i := range node.SubAgencies {
+			queue = append(queue, &node.SubAgencies[i])
+		}
+	}
+	return nil
+}
+
+// GetAgencyInfoSorted returns agency information as a struct with its
+// SubAgencies sorted at every depth according to cmp.
+func GetAgencyInfoSorted(agency string, cmp Comparator) (*Agency, error) {
+	info, err := GetAgencyInfo(agency)
+	if err != nil {
+		return nil, err
+	}
+
+	SortTree(info, cmp)
+	return info, nil
+}
+
+// GetAllAgenciesSorted returns full Agency details for every known agency,
+// sorted according to cmp.
+func GetAllAgenciesSorted(cmp Comparator) ([]Agency, error) {
+	acronyms, err := GetAllAgencies()
+	if err != nil {
+		return nil, err
+	}
+	return agencyInfosSorted(acronyms, cmp)
+}
+
+// GetAgenciesByTierSorted returns full Agency details for every agency in
+// tier, sorted according to cmp.
+func GetAgenciesByTierSorted(tier int, cmp Comparator) ([]Agency, error) {
+	acronyms, err := GetAgenciesByTier(tier)
+	if err != nil {
+		return nil, err
+	}
+	return agencyInfosSorted(acronyms, cmp)
+}
+
+// GetAgenciesByDomainSorted returns full Agency details for every agency
+// in domain, sorted according to cmp.
+func GetAgenciesByDomainSorted(domain string, cmp Comparator) ([]Agency, error) {
+	acronyms, err := GetAgenciesByDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+	return agencyInfosSorted(acronyms, cmp)
+}
+
+// agencyInfosSorted resolves every acronym to its full Agency info in a
+// single batched round-trip (see GetContextsBatch) and returns the
+// results sorted according to cmp, rather than paying one FFI round-trip
+// per acronym.
+func agencyInfosSorted(acronyms []string, cmp Comparator) ([]Agency, error) {
+	contexts, err := GetContextsBatch(acronyms)
+	if err != nil {
+		return nil, err
+	}
+
+	agencies := make([]Agency, 0, len(acronyms))
+	for _, acronym := range acronyms {
+		agencies = append(agencies, *agencyInfoFromContext(acronym, contexts[acronym]))
+	}
+
+	SortAgencies(agencies, cmp)
+	return agencies, nil
+}
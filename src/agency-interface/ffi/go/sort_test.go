@@ -0,0 +1,107 @@
+package agency
+
+import (
+	"reflect"
+	"testing"
+)
+
+func sampleTree() Agency {
+	return Agency{
+		Acronym: "DOD",
+		Name:    "Department of Defense",
+		Domain:  "defense",
+		Tier:    1,
+		SubAgencies: []Agency{
+			{Acronym: "ARMY", Name: "Army", Domain: "defense", Tier: 2},
+			{Acronym: "AF", Name: "Air Force", Domain: "defense", Tier: 2,
+				SubAgencies: []Agency{
+					{Acronym: "SF", Name: "Space Force", Domain: "defense", Tier: 3},
+				}},
+		},
+	}
+}
+
+func TestSortAgenciesByAcronym(t *testing.T) {
+	items := []Agency{{Acronym: "NASA"}, {Acronym: "DOD"}, {Acronym: "EPA"}}
+	SortAgencies(items, ByAcronym)
+
+	got := []string{items[0].Acronym, items[1].Acronym, items[2].Acronym}
+	want := []string{"DOD", "EPA", "NASA"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortAgencies(ByAcronym) = %v, want %v", got, want)
+	}
+}
+
+func TestReverseComparator(t *testing.T) {
+	items := []Agency{{Acronym: "DOD"}, {Acronym: "NASA"}, {Acronym: "EPA"}}
+	SortAgencies(items, Reverse(ByAcronym))
+
+	got := []string{items[0].Acronym, items[1].Acronym, items[2].Acronym}
+	want := []string{"NASA", "EPA", "DOD"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("SortAgencies(Reverse(ByAcronym)) = %v, want %v", got, want)
+	}
+}
+
+func TestSortTree(t *testing.T) {
+	root := sampleTree()
+	SortTree(&root, ByAcronym)
+
+	if root.SubAgencies[0].Acronym != "AF" || root.SubAgencies[1].Acronym != "ARMY" {
+		t.Fatalf("SortTree did not sort top-level sub-agencies: %+v", root.SubAgencies)
+	}
+}
+
+func TestWalkPreOrder(t *testing.T) {
+	root := sampleTree()
+
+	var visited []string
+	err := Walk(&root, PreOrder, func(a *Agency) error {
+		visited = append(visited, a.Acronym)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk returned error: %v", err)
+	}
+
+	want := []string{"DOD", "ARMY", "AF", "SF"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("Walk(PreOrder) visited = %v, want %v", visited, want)
+	}
+}
+
+func TestWalkPostOrder(t *testing.T) {
+	root := sampleTree()
+
+	var visited []string
+	err := Walk(&root, PostOrder, func(a *Agency) error {
+		visited = append(visited, a.Acronym)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk returned error: %v", err)
+	}
+
+	want := []string{"ARMY", "SF", "AF", "DOD"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("Walk(PostOrder) visited = %v, want %v", visited, want)
+	}
+}
+
+func TestWalkBreadthFirst(t *testing.T) {
+	root := sampleTree()
+
+	var visited []string
+	err := Walk(&root, BreadthFirst, func(a *Agency) error {
+		visited = append(visited, a.Acronym)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Walk returned error: %v", err)
+	}
+
+	want := []string{"DOD", "ARMY", "AF", "SF"}
+	if !reflect.DeepEqual(visited, want) {
+		t.Errorf("Walk(BreadthFirst) visited = %v, want %v", visited, want)
+	}
+}